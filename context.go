@@ -0,0 +1,35 @@
+package logger
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+// loggerKey is the context.Context key under which a request-scoped
+// *zap.Logger is stored by WithContext.
+type loggerKey struct{}
+
+// WithContext returns a copy of ctx carrying l, retrievable later via
+// FromContext. Use it to thread a request-scoped logger (e.g. one
+// annotated with a request ID) through downstream calls.
+func WithContext(ctx context.Context, l *zap.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, l)
+}
+
+// FromContext returns the logger previously attached to ctx via
+// WithContext, or the global logger if none was attached.
+func FromContext(ctx context.Context) *zap.Logger {
+	if l, ok := ctx.Value(loggerKey{}).(*zap.Logger); ok {
+		return l
+	}
+	return zap.L()
+}
+
+// WithFields returns a copy of ctx whose logger (as returned by
+// FromContext) has fields appended to it, so every downstream log line
+// carries them automatically. Typical callers attach a request ID, trace
+// ID, or user ID once at the top of a handler.
+func WithFields(ctx context.Context, fields ...zap.Field) context.Context {
+	return WithContext(ctx, FromContext(ctx).With(fields...))
+}
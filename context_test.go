@@ -0,0 +1,43 @@
+package logger
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestFromContext_FallsBackToGlobal(t *testing.T) {
+	if got := FromContext(context.Background()); got != zap.L() {
+		t.Errorf("expected FromContext with no attached logger to return the global logger, got %p", got)
+	}
+}
+
+func TestWithContext_RoundTrips(t *testing.T) {
+	core, _ := observer.New(zapcore.DebugLevel)
+	l := zap.New(core)
+
+	ctx := WithContext(context.Background(), l)
+	if got := FromContext(ctx); got != l {
+		t.Errorf("expected FromContext to return the logger attached via WithContext, got %p want %p", got, l)
+	}
+}
+
+func TestWithFields_AttachesFieldsToDownstreamLogs(t *testing.T) {
+	core, logs := observer.New(zapcore.DebugLevel)
+	l := zap.New(core)
+
+	ctx := WithContext(context.Background(), l)
+	ctx = WithFields(ctx, zap.String("request_id", "abc123"))
+	FromContext(ctx).Info("hello")
+
+	entries := logs.All()
+	if len(entries) != 1 {
+		t.Fatalf("expected 1 log entry, got %d", len(entries))
+	}
+	if got := entries[0].ContextMap()["request_id"]; got != "abc123" {
+		t.Errorf("expected request_id field to carry through, got %v", got)
+	}
+}
@@ -0,0 +1,50 @@
+package logger
+
+import (
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// EncoderConfig pairs an encoder configuration with the level it should
+// log at, letting WithEncoders configure the console and file sinks
+// independently. Leave Level nil to fall back to the level parsed from
+// InitLogger's logLevel argument (and kept live via GetLevel/LevelHandler).
+type EncoderConfig struct {
+	zapcore.EncoderConfig
+	Level zapcore.LevelEnabler
+}
+
+type encodersConfig struct {
+	console EncoderConfig
+	file    EncoderConfig
+}
+
+// DefaultConsoleEncoderConfig returns a colored, human-friendly encoder
+// configuration suitable for local/interactive stdout output.
+func DefaultConsoleEncoderConfig() EncoderConfig {
+	cfg := zap.NewDevelopmentEncoderConfig()
+	cfg.EncodeTime = zapcore.TimeEncoderOfLayout("2006-01-02 15:04:05")
+	cfg.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	return EncoderConfig{EncoderConfig: cfg}
+}
+
+// DefaultFileEncoderConfig returns a JSON encoder configuration suitable
+// for machine ingestion by log shippers (Loki, ELK, Datadog).
+func DefaultFileEncoderConfig() EncoderConfig {
+	cfg := zap.NewProductionEncoderConfig()
+	cfg.EncodeTime = zapcore.TimeEncoderOfLayout("2006-01-02 15:04:05")
+	return EncoderConfig{EncoderConfig: cfg}
+}
+
+// WithEncoders switches InitLogger from its single development-console
+// encoder to two independent cores combined with zapcore.NewTee: console
+// is used for the stdout sink and file for the file sink, each with its
+// own encoding and level. This lets local/interactive output stay
+// human-readable while log-shippers consume structured JSON from the
+// file. Start from DefaultConsoleEncoderConfig/DefaultFileEncoderConfig
+// and override individual fields as needed.
+func WithEncoders(console, file EncoderConfig) Option {
+	return func(o *options) {
+		o.encoders = &encodersConfig{console: console, file: file}
+	}
+}
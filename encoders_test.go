@@ -0,0 +1,72 @@
+package logger
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/zap/zapcore"
+)
+
+func TestDefaultEncoderConfigs_LeaveLevelNilForInitLoggerToFill(t *testing.T) {
+	console := DefaultConsoleEncoderConfig()
+	if console.Level != nil {
+		t.Errorf("expected DefaultConsoleEncoderConfig.Level to be nil so InitLogger's parsed level applies, got %v", console.Level)
+	}
+	if console.EncodeLevel == nil {
+		t.Error("expected a colored level encoder to be configured")
+	}
+
+	file := DefaultFileEncoderConfig()
+	if file.Level != nil {
+		t.Errorf("expected DefaultFileEncoderConfig.Level to be nil so InitLogger's parsed level applies, got %v", file.Level)
+	}
+}
+
+func TestWithEncoders_FileSinkWritesJSONAtConfiguredLevel(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "app.log")
+
+	fileCfg := DefaultFileEncoderConfig()
+	fileCfg.Level = zapcore.WarnLevel
+
+	l, err := InitLogger("debug", path, false, WithEncoders(DefaultConsoleEncoderConfig(), fileCfg))
+	if err != nil {
+		t.Fatalf("InitLogger returned error: %v", err)
+	}
+
+	l.Info("should be filtered out")
+	l.Warn("should be recorded")
+	if err := l.Sync(); err != nil {
+		t.Fatalf("Sync returned error: %v", err)
+	}
+	CloseLogger()
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("expected log file to exist: %v", err)
+	}
+	defer f.Close()
+
+	var entries []map[string]any
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var m map[string]any
+		if err := json.Unmarshal(scanner.Bytes(), &m); err != nil {
+			t.Fatalf("expected a JSON-encoded line, got %q: %v", scanner.Text(), err)
+		}
+		entries = append(entries, m)
+	}
+
+	if len(entries) != 1 {
+		t.Fatalf("expected exactly 1 entry at warn level, got %d", len(entries))
+	}
+	if entries[0]["level"] != "warn" {
+		t.Errorf("expected recorded entry to be at warn level, got %v", entries[0]["level"])
+	}
+	if entries[0]["msg"] != "should be recorded" {
+		t.Errorf("expected recorded entry message, got %v", entries[0]["msg"])
+	}
+}
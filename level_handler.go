@@ -0,0 +1,43 @@
+package logger
+
+import "net/http"
+
+// LevelHandlerOption configures the handler returned by LevelHandler.
+type LevelHandlerOption func(*levelHandlerConfig)
+
+type levelHandlerConfig struct {
+	authorize func(*http.Request) bool
+}
+
+// WithLevelHandlerAuth gates the level handler behind authorize, which
+// should return true if the request is allowed to proceed. Requests that
+// fail authorization receive a 403 Forbidden. Use this to guard the
+// endpoint before mounting it in a production server.
+func WithLevelHandlerAuth(authorize func(*http.Request) bool) LevelHandlerOption {
+	return func(c *levelHandlerConfig) {
+		c.authorize = authorize
+	}
+}
+
+// LevelHandler returns an http.Handler that exposes the global logger's
+// level as JSON, mirroring zap's built-in AtomicLevel.ServeHTTP:
+//
+//	GET  -> {"level":"info"}
+//	PUT  -> {"level":"debug"} to change it, returning the new level
+//
+// Mount it wherever the running service exposes operational endpoints,
+// e.g. mux.Handle("/log/level", logger.LevelHandler()).
+func LevelHandler(opts ...LevelHandlerOption) http.Handler {
+	cfg := &levelHandlerConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if cfg.authorize != nil && !cfg.authorize(r) {
+			http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+			return
+		}
+		level.ServeHTTP(w, r)
+	})
+}
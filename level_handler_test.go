@@ -0,0 +1,62 @@
+package logger
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestLevelHandler_GetAndPut(t *testing.T) {
+	level = zap.NewAtomicLevelAt(zapcore.InfoLevel)
+	h := LevelHandler()
+
+	req := httptest.NewRequest(http.MethodGet, "/log/level", nil)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET: expected 200, got %d", rec.Code)
+	}
+	if !strings.Contains(rec.Body.String(), `"info"`) {
+		t.Errorf("GET: expected body to report info level, got %q", rec.Body.String())
+	}
+
+	req = httptest.NewRequest(http.MethodPut, "/log/level", strings.NewReader(`{"level":"debug"}`))
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("PUT: expected 200, got %d", rec.Code)
+	}
+	if got := level.Level(); got != zapcore.DebugLevel {
+		t.Errorf("PUT: expected level to change to debug, got %v", got)
+	}
+}
+
+func TestLevelHandler_WithAuthRejectsUnauthorized(t *testing.T) {
+	level = zap.NewAtomicLevelAt(zapcore.InfoLevel)
+	h := LevelHandler(WithLevelHandlerAuth(func(r *http.Request) bool {
+		return r.Header.Get("Authorization") == "secret"
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/log/level", nil)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("expected 403 for unauthorized request, got %d", rec.Code)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/log/level", nil)
+	req.Header.Set("Authorization", "secret")
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("expected 200 for authorized request, got %d", rec.Code)
+	}
+}
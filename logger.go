@@ -8,12 +8,46 @@ import (
 
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	"gopkg.in/natefinch/lumberjack.v2"
 )
 
+// sinks is the package-level registry backing the global logger. Callers
+// can register, swap, or tear down named sinks at runtime (e.g. to turn on
+// a debug file sink on a running server) via AddCore, RemoveCore, and
+// ReplaceCore without losing whatever is already logging to stdout.
+var sinks = newLockedMultiCore()
+
+// level is the AtomicLevel backing the global logger, retained so callers
+// can inspect or change it at runtime via GetLevel and LevelHandler.
+var level = zap.NewAtomicLevel()
+
+// rotator is the lumberjack rotating writer in use, if the last InitLogger
+// call used WithFileRotation with a file path. CloseLogger closes it.
+var rotator *lumberjack.Logger
+
+// plainFile is the unrotated file opened directly by the WithEncoders
+// branch, if any. CloseLogger flushes and closes it.
+var plainFile *os.File
+
 // InitLogger initializes and configures the global Zap logger.
 // It writes logs to the specified file and optionally to stdout.
 // Use logToStdout=true to enable dual output (both file and console).
-func InitLogger(logLevel string, logFilePath string, logToStdout bool) (*zap.Logger, error) {
+//
+// The returned logger is backed by a lockedMultiCore registry: additional
+// named sinks can be added, removed, or replaced afterwards via AddCore,
+// RemoveCore, and ReplaceCore. Pass functional Options (e.g. WithSampling)
+// to tune behavior beyond the basic level/path/stdout arguments.
+func InitLogger(logLevel string, logFilePath string, logToStdout bool, opts ...Option) (*zap.Logger, error) {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	// Forget sinks opened by a previous call, so CloseLogger only closes
+	// what this call opens.
+	rotator = nil
+	plainFile = nil
+
 	// Create a default configuration (Default is Debug level, Console encoding)
 	cfg := zap.NewDevelopmentConfig()
 
@@ -22,12 +56,77 @@ func InitLogger(logLevel string, logFilePath string, logToStdout bool) (*zap.Log
 	cfg.DisableStacktrace = true
 
 	// Set the Log Level
-	if level, err := zapcore.ParseLevel(logLevel); err == nil {
-		cfg.Level = zap.NewAtomicLevelAt(level)
+	if parsed, err := zapcore.ParseLevel(logLevel); err == nil {
+		cfg.Level = zap.NewAtomicLevelAt(parsed)
 	} else {
 		// Debug in NewDevelopmentConfig, just log the warning
 		fmt.Printf("Invalid log level '%s', defaulting to DEBUG\n", logLevel)
 	}
+	level = cfg.Level
+
+	// WithEncoders replaces the single development-console encoder with
+	// two independently configured cores (console + file) combined via
+	// zapcore.NewTee, so local output stays human-readable while the
+	// file carries structured JSON for log-shippers.
+	if o.encoders != nil {
+		consoleLevel := o.encoders.console.Level
+		if consoleLevel == nil {
+			consoleLevel = cfg.Level
+		}
+		fileLevel := o.encoders.file.Level
+		if fileLevel == nil {
+			fileLevel = cfg.Level
+		}
+
+		var cores []zapcore.Core
+		if logToStdout {
+			cores = append(cores, zapcore.NewCore(
+				zapcore.NewConsoleEncoder(o.encoders.console.EncoderConfig),
+				zapcore.Lock(os.Stdout),
+				consoleLevel,
+			))
+		}
+		if logFilePath != "" {
+			var fileSink zapcore.WriteSyncer
+			if o.rotation != nil {
+				rotator = o.rotation.newRotator(logFilePath)
+				fileSink = zapcore.AddSync(rotator)
+			} else {
+				f, err := os.OpenFile(logFilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+				if err != nil {
+					return nil, fmt.Errorf("failed to open log file: %w", err)
+				}
+				plainFile = f
+				fileSink = zapcore.AddSync(f)
+			}
+			cores = append(cores, zapcore.NewCore(
+				zapcore.NewJSONEncoder(o.encoders.file.EncoderConfig),
+				fileSink,
+				fileLevel,
+			))
+		}
+		sinks = newLockedMultiCore(o.wrapCore(zapcore.NewTee(cores...)))
+		logger := zap.New(sinks, zap.AddCaller())
+		zap.ReplaceGlobals(logger)
+		return logger, nil
+	}
+
+	// A rotated file sink can't be expressed as a zap.Config output path,
+	// since zap only knows how to open plain files/stdout/stderr. Build
+	// the core by hand in that case; otherwise keep letting zap.Config
+	// handle file/stdout opening as before.
+	if o.rotation != nil && logFilePath != "" {
+		rotator = o.rotation.newRotator(logFilePath)
+		encoder := zapcore.NewConsoleEncoder(cfg.EncoderConfig)
+		cores := []zapcore.Core{zapcore.NewCore(encoder, zapcore.AddSync(rotator), cfg.Level)}
+		if logToStdout {
+			cores = append(cores, zapcore.NewCore(encoder, zapcore.Lock(os.Stdout), cfg.Level))
+		}
+		sinks = newLockedMultiCore(o.wrapCore(zapcore.NewTee(cores...)))
+		logger := zap.New(sinks, zap.AddCaller())
+		zap.ReplaceGlobals(logger)
+		return logger, nil
+	}
 
 	// Configure Output Paths
 	// zap.Config handles opening files and "stdout" logic automatically
@@ -39,8 +138,12 @@ func InitLogger(logLevel string, logFilePath string, logToStdout bool) (*zap.Log
 		cfg.OutputPaths = append(cfg.OutputPaths, "stdout")
 	}
 
-	// Build the logger
-	logger, err := cfg.Build()
+	// Build the logger, wrapping its core in the sink registry so
+	// additional cores can be added or removed at runtime.
+	logger, err := cfg.Build(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		sinks = newLockedMultiCore(o.wrapCore(core))
+		return sinks
+	}))
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize logger: %w", err)
 	}
@@ -49,6 +152,31 @@ func InitLogger(logLevel string, logFilePath string, logToStdout bool) (*zap.Log
 	return logger, nil
 }
 
+// AddCore registers a new named core with the running logger, e.g. to turn
+// on a debug file sink without restarting the process. If name is already
+// registered, the existing core is replaced.
+func AddCore(name string, c zapcore.Core) {
+	sinks.AddCore(name, c)
+}
+
+// RemoveCore removes the named core from the running logger, if present.
+func RemoveCore(name string) {
+	sinks.RemoveCore(name)
+}
+
+// ReplaceCore swaps the core registered under name for c, adding it if
+// name is not yet registered.
+func ReplaceCore(name string, c zapcore.Core) {
+	sinks.ReplaceCore(name, c)
+}
+
+// GetLevel returns the zap.AtomicLevel backing the global logger. Callers
+// can change it directly, bind it into their own router, or mount
+// LevelHandler to expose it over HTTP.
+func GetLevel() zap.AtomicLevel {
+	return level
+}
+
 // CloseLogger flushes any buffered log entries.
 // Should be called using defer in your main function.
 func CloseLogger() {
@@ -73,4 +201,16 @@ func CloseLogger() {
 		// If it wasn't a whitelisted error, print it.
 		fmt.Fprintf(os.Stderr, "Error flushing logger: %v\n", err)
 	}
+
+	if rotator != nil {
+		if err := rotator.Close(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error closing rotated log file: %v\n", err)
+		}
+	}
+
+	if plainFile != nil {
+		if err := plainFile.Close(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error closing log file: %v\n", err)
+		}
+	}
 }
@@ -0,0 +1,71 @@
+package logger
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// requestIDHeader is the response header the middleware sets so clients
+// and downstream proxies can correlate a request with its log lines.
+const requestIDHeader = "X-Request-Id"
+
+// newRequestID returns a short random hex identifier for a request.
+func newRequestID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(b)
+}
+
+// statusRecorder wraps an http.ResponseWriter to capture the status code
+// written, since the standard library doesn't expose it after the fact.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// Middleware returns net/http middleware that generates a request ID,
+// injects a child logger carrying it into the request's context (see
+// WithContext/FromContext), and logs the request's start and end with
+// latency, status, and method.
+//
+// For gin, use GinMiddleware instead: gin writes responses through its
+// own gin.ResponseWriter, so wrapping it in statusRecorder here would
+// never see the real status, and gin.Context.Writer.Status() must be
+// read after c.Next() runs instead.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := newRequestID()
+		w.Header().Set(requestIDHeader, requestID)
+
+		reqLogger := zap.L().With(zap.String("request_id", requestID))
+		ctx := WithContext(r.Context(), reqLogger)
+		r = r.WithContext(ctx)
+
+		reqLogger.Info("request started",
+			zap.String("method", r.Method),
+			zap.String("path", r.URL.Path),
+		)
+
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+
+		reqLogger.Info("request completed",
+			zap.String("method", r.Method),
+			zap.String("path", r.URL.Path),
+			zap.Int("status", rec.status),
+			zap.Duration("latency", time.Since(start)),
+		)
+	})
+}
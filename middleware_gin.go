@@ -0,0 +1,43 @@
+package logger
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// GinMiddleware returns the gin equivalent of Middleware: it generates a
+// request ID, injects a child logger carrying it into the request's
+// context (see WithContext/FromContext), and logs the request's start
+// and end with latency, status, and method.
+//
+// Unlike Middleware, this does not wrap the ResponseWriter: gin's own
+// router writes the response through gin.Context.Writer regardless of
+// what's passed to c.Next(), so the real status and size are read from
+// c.Writer.Status()/Size() after c.Next() returns.
+func GinMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := newRequestID()
+		c.Writer.Header().Set(requestIDHeader, requestID)
+
+		reqLogger := zap.L().With(zap.String("request_id", requestID))
+		c.Request = c.Request.WithContext(WithContext(c.Request.Context(), reqLogger))
+
+		reqLogger.Info("request started",
+			zap.String("method", c.Request.Method),
+			zap.String("path", c.Request.URL.Path),
+		)
+
+		start := time.Now()
+		c.Next()
+
+		reqLogger.Info("request completed",
+			zap.String("method", c.Request.Method),
+			zap.String("path", c.Request.URL.Path),
+			zap.Int("status", c.Writer.Status()),
+			zap.Int("size", c.Writer.Size()),
+			zap.Duration("latency", time.Since(start)),
+		)
+	}
+}
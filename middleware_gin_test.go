@@ -0,0 +1,49 @@
+package logger
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestGinMiddleware_LogsRealStatus(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	core, logs := observer.New(zapcore.DebugLevel)
+	orig := zap.L()
+	zap.ReplaceGlobals(zap.New(core))
+	defer zap.ReplaceGlobals(orig)
+
+	r := gin.New()
+	r.Use(GinMiddleware())
+	r.GET("/teapot", func(c *gin.Context) {
+		c.Status(http.StatusTeapot)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/teapot", nil)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusTeapot {
+		t.Fatalf("expected response status %d, got %d", http.StatusTeapot, rec.Code)
+	}
+
+	var completed *observer.LoggedEntry
+	for _, e := range logs.All() {
+		e := e
+		if e.Message == "request completed" {
+			completed = &e
+		}
+	}
+	if completed == nil {
+		t.Fatal(`expected a "request completed" log entry`)
+	}
+	if got := completed.ContextMap()["status"]; got != int64(http.StatusTeapot) {
+		t.Errorf("expected logged status %d, got %v", http.StatusTeapot, got)
+	}
+}
@@ -0,0 +1,34 @@
+package logger
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+func TestMiddleware_SetsRequestIDAndInjectsLogger(t *testing.T) {
+	var gotRequestID string
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotRequestID = r.Header.Get(requestIDHeader)
+		if l := FromContext(r.Context()); l == nil || l == zap.L() {
+			t.Errorf("expected a logger to be attached to the request context")
+		}
+		w.WriteHeader(http.StatusTeapot)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/widgets", nil)
+	rec := httptest.NewRecorder()
+	Middleware(next).ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusTeapot {
+		t.Errorf("expected downstream status to pass through, got %d", rec.Code)
+	}
+	if rec.Header().Get(requestIDHeader) == "" {
+		t.Errorf("expected response to carry a request ID header")
+	}
+	if gotRequestID != "" {
+		t.Errorf("request ID header should not be set on the inbound request, only the response")
+	}
+}
@@ -0,0 +1,147 @@
+package logger
+
+import (
+	"fmt"
+	"sync"
+
+	"go.uber.org/multierr"
+	"go.uber.org/zap/zapcore"
+)
+
+// seedCoreNamePrefix names cores seeded via newLockedMultiCore. It starts
+// with a NUL byte so that ordinary caller-supplied names do not collide
+// with it and silently replace the original sink; the empty name is
+// rejected by AddCore.
+const seedCoreNamePrefix = "\x00seed:"
+
+// namedCore pairs a zapcore.Core with the name it was registered under.
+type namedCore struct {
+	name string
+	core zapcore.Core
+}
+
+// lockedMultiCore is a zapcore.Core that fans calls out to a dynamic set of
+// named cores. Cores can be added, removed, or replaced at runtime (e.g. to
+// enable a debug file sink on a running server) without losing whatever is
+// already logging to stdout. All access to the underlying slice is guarded
+// by a RWMutex so it is safe to call from multiple goroutines concurrently
+// with logging itself.
+type lockedMultiCore struct {
+	mu    sync.RWMutex
+	cores []namedCore
+}
+
+// newLockedMultiCore builds a lockedMultiCore seeded with the given cores.
+// Seeded cores are registered under reserved names built from
+// seedCoreNamePrefix, so AddCore/RemoveCore/ReplaceCore calls with
+// ordinary names neither remove nor replace them.
+func newLockedMultiCore(cores ...zapcore.Core) *lockedMultiCore {
+	m := &lockedMultiCore{cores: make([]namedCore, len(cores))}
+	for i, c := range cores {
+		m.cores[i] = namedCore{name: fmt.Sprintf("%s%d", seedCoreNamePrefix, i), core: c}
+	}
+	return m
+}
+
+// AddCore registers a new named core. If name is already registered, the
+// existing core is replaced, matching the semantics of ReplaceCore.
+// AddCore panics if name is empty, since an empty name could otherwise
+// silently collide with another empty-named core and replace it.
+func (m *lockedMultiCore) AddCore(name string, c zapcore.Core) {
+	if name == "" {
+		panic("logger: AddCore name must not be empty")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i, nc := range m.cores {
+		if nc.name == name {
+			m.cores[i].core = c
+			return
+		}
+	}
+	m.cores = append(m.cores, namedCore{name: name, core: c})
+}
+
+// RemoveCore removes the named core, if present. It is a no-op otherwise.
+func (m *lockedMultiCore) RemoveCore(name string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i, nc := range m.cores {
+		if nc.name == name {
+			m.cores = append(m.cores[:i], m.cores[i+1:]...)
+			return
+		}
+	}
+}
+
+// ReplaceCore swaps the core registered under name for c. If name is not
+// yet registered, it is added, so ReplaceCore can also be used to add a
+// first core under a stable name.
+func (m *lockedMultiCore) ReplaceCore(name string, c zapcore.Core) {
+	m.AddCore(name, c)
+}
+
+// snapshot returns a copy of the currently registered cores, safe to range
+// over without holding the lock.
+func (m *lockedMultiCore) snapshot() []namedCore {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	cores := make([]namedCore, len(m.cores))
+	copy(cores, m.cores)
+	return cores
+}
+
+// Enabled reports whether any registered core is enabled for level.
+func (m *lockedMultiCore) Enabled(level zapcore.Level) bool {
+	for _, nc := range m.snapshot() {
+		if nc.core.Enabled(level) {
+			return true
+		}
+	}
+	return false
+}
+
+// With returns a new lockedMultiCore wrapping each current core with the
+// given fields.
+func (m *lockedMultiCore) With(fields []zapcore.Field) zapcore.Core {
+	cores := m.snapshot()
+	with := &lockedMultiCore{cores: make([]namedCore, len(cores))}
+	for i, nc := range cores {
+		with.cores[i] = namedCore{name: nc.name, core: nc.core.With(fields)}
+	}
+	return with
+}
+
+// Check lets every registered core add itself to ce, mirroring
+// zapcore.NewTee, so each core applies its own level (and any sampling
+// it wraps) to the entry.
+func (m *lockedMultiCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	for _, nc := range m.snapshot() {
+		ce = nc.core.Check(ent, ce)
+	}
+	return ce
+}
+
+// Write fans the entry and fields out to every registered core, aggregating
+// any errors via multierr.Append.
+func (m *lockedMultiCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	var err error
+	for _, nc := range m.snapshot() {
+		err = multierr.Append(err, nc.core.Write(ent, fields))
+	}
+	return err
+}
+
+// Sync flushes every registered core, aggregating any errors via
+// multierr.Append.
+func (m *lockedMultiCore) Sync() error {
+	var err error
+	for _, nc := range m.snapshot() {
+		err = multierr.Append(err, nc.core.Sync())
+	}
+	return err
+}
@@ -0,0 +1,77 @@
+package logger
+
+import (
+	"testing"
+
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestLockedMultiCore_SeededCoreSurvivesAddRemove(t *testing.T) {
+	baseCore, baseLogs := observer.New(zapcore.DebugLevel)
+	extraCore, extraLogs := observer.New(zapcore.DebugLevel)
+
+	m := newLockedMultiCore(baseCore)
+	m.AddCore("extra", extraCore)
+	m.RemoveCore("extra")
+
+	ent := zapcore.Entry{Level: zapcore.InfoLevel, Message: "hello"}
+	if err := m.Write(ent, nil); err != nil {
+		t.Fatalf("Write returned error: %v", err)
+	}
+
+	if got := baseLogs.Len(); got != 1 {
+		t.Errorf("seeded base core should still receive writes after adding/removing another core, got %d entries", got)
+	}
+	if got := extraLogs.Len(); got != 0 {
+		t.Errorf("removed extra core should not receive writes, got %d entries", got)
+	}
+}
+
+func TestLockedMultiCore_AddCoreReplacesSameName(t *testing.T) {
+	baseCore, baseLogs := observer.New(zapcore.DebugLevel)
+	firstCore, firstLogs := observer.New(zapcore.DebugLevel)
+	secondCore, secondLogs := observer.New(zapcore.DebugLevel)
+
+	m := newLockedMultiCore(baseCore)
+	m.AddCore("extra", firstCore)
+	m.AddCore("extra", secondCore)
+
+	ent := zapcore.Entry{Level: zapcore.InfoLevel, Message: "hello"}
+	if err := m.Write(ent, nil); err != nil {
+		t.Fatalf("Write returned error: %v", err)
+	}
+
+	if got := baseLogs.Len(); got != 1 {
+		t.Errorf("seeded base core should still receive writes, got %d entries", got)
+	}
+	if got := firstLogs.Len(); got != 0 {
+		t.Errorf("replaced core should not receive writes, got %d entries", got)
+	}
+	if got := secondLogs.Len(); got != 1 {
+		t.Errorf("replacement core should receive writes, got %d entries", got)
+	}
+}
+
+func TestLockedMultiCore_AddCorePanicsOnEmptyName(t *testing.T) {
+	baseCore, baseLogs := observer.New(zapcore.DebugLevel)
+	extraCore, _ := observer.New(zapcore.DebugLevel)
+
+	m := newLockedMultiCore(baseCore)
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected AddCore(\"\", ...) to panic")
+		}
+
+		ent := zapcore.Entry{Level: zapcore.InfoLevel, Message: "hello"}
+		if err := m.Write(ent, nil); err != nil {
+			t.Fatalf("Write returned error: %v", err)
+		}
+		if got := baseLogs.Len(); got != 1 {
+			t.Errorf("seeded base core should survive a rejected empty-name AddCore, got %d entries", got)
+		}
+	}()
+
+	m.AddCore("", extraCore)
+}
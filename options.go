@@ -0,0 +1,44 @@
+package logger
+
+import (
+	"time"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// options collects the settings contributed by the functional Options
+// passed to InitLogger.
+type options struct {
+	sampling *samplingConfig
+	rotation *FileRotation
+	encoders *encodersConfig
+}
+
+type samplingConfig struct {
+	first      int
+	thereafter int
+	tick       time.Duration
+}
+
+// Option configures optional InitLogger behavior.
+type Option func(*options)
+
+// WithSampling enables log sampling so that, per tick, the first log
+// entries are let through and only every thereafter-th one after that,
+// per message and level. This bounds CPU/IO under bursty, highly
+// repetitive logging instead of writing every occurrence. Sampling is
+// disabled by default; call this to tune it for production.
+func WithSampling(first, thereafter int, tick time.Duration) Option {
+	return func(o *options) {
+		o.sampling = &samplingConfig{first: first, thereafter: thereafter, tick: tick}
+	}
+}
+
+// wrapCore applies the configured options to core, in the order they
+// should be layered around the underlying sink.
+func (o *options) wrapCore(core zapcore.Core) zapcore.Core {
+	if o.sampling != nil {
+		core = zapcore.NewSamplerWithOptions(core, o.sampling.tick, o.sampling.first, o.sampling.thereafter)
+	}
+	return core
+}
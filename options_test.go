@@ -0,0 +1,41 @@
+package logger
+
+import (
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestWithSampling_DropsRepeatsPastThereafter(t *testing.T) {
+	core, logs := observer.New(zapcore.DebugLevel)
+
+	o := &options{}
+	WithSampling(2, 100, time.Minute)(o)
+	l := zap.New(o.wrapCore(core))
+
+	for i := 0; i < 10; i++ {
+		l.Info("repeat")
+	}
+
+	if got := logs.Len(); got != 2 {
+		t.Errorf("expected only the initial burst of 2 to pass through, got %d", got)
+	}
+}
+
+func TestNoSampling_PassesEverythingThrough(t *testing.T) {
+	core, logs := observer.New(zapcore.DebugLevel)
+
+	o := &options{}
+	l := zap.New(o.wrapCore(core))
+
+	for i := 0; i < 10; i++ {
+		l.Info("repeat")
+	}
+
+	if got := logs.Len(); got != 10 {
+		t.Errorf("expected all 10 entries to pass through without sampling configured, got %d", got)
+	}
+}
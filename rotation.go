@@ -0,0 +1,45 @@
+package logger
+
+import "gopkg.in/natefinch/lumberjack.v2"
+
+// FileRotation configures size/age/backup-based rotation for the file
+// sink, so long-running processes don't depend on an external logrotate.
+type FileRotation struct {
+	// MaxSizeMB is the maximum size in megabytes a log file is allowed to
+	// reach before it gets rotated.
+	MaxSizeMB int
+	// MaxBackups is the maximum number of old rotated files to retain.
+	// Zero means retain all of them.
+	MaxBackups int
+	// MaxAgeDays is the maximum number of days to retain old rotated
+	// files, regardless of MaxBackups. Zero means files are not removed
+	// based on age.
+	MaxAgeDays int
+	// Compress determines whether rotated files are gzip-compressed.
+	Compress bool
+	// LocalTime determines whether the timestamp in rotated file names
+	// is the computer's local time; UTC is used otherwise.
+	LocalTime bool
+}
+
+// WithFileRotation replaces the raw file sink with one rotated by size,
+// age, and backup count as described by r. It has no effect if InitLogger
+// is called without a file path.
+func WithFileRotation(r FileRotation) Option {
+	return func(o *options) {
+		o.rotation = &r
+	}
+}
+
+// newRotator builds the lumberjack rotating writer for path. It has no
+// Sync method; callers wrap it with zapcore.AddSync.
+func (r *FileRotation) newRotator(path string) *lumberjack.Logger {
+	return &lumberjack.Logger{
+		Filename:   path,
+		MaxSize:    r.MaxSizeMB,
+		MaxBackups: r.MaxBackups,
+		MaxAge:     r.MaxAgeDays,
+		Compress:   r.Compress,
+		LocalTime:  r.LocalTime,
+	}
+}
@@ -0,0 +1,62 @@
+package logger
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFileRotation_NewRotatorWritesToPath(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "app.log")
+
+	r := FileRotation{MaxSizeMB: 1, MaxBackups: 3, MaxAgeDays: 7, Compress: true, LocalTime: true}
+	lj := r.newRotator(path)
+
+	if _, err := lj.Write([]byte("hello\n")); err != nil {
+		t.Fatalf("Write returned error: %v", err)
+	}
+	if err := lj.Close(); err != nil {
+		t.Fatalf("Close returned error: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("expected log file to exist: %v", err)
+	}
+	if string(data) != "hello\n" {
+		t.Errorf("expected file contents %q, got %q", "hello\n", string(data))
+	}
+}
+
+func TestInitLogger_WithFileRotation_CloseLoggerClosesRotatorCleanly(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "app.log")
+
+	l, err := InitLogger("info", path, false, WithFileRotation(FileRotation{MaxSizeMB: 1}))
+	if err != nil {
+		t.Fatalf("InitLogger returned error: %v", err)
+	}
+	if rotator == nil {
+		t.Fatal("expected InitLogger to install a rotator when WithFileRotation is set")
+	}
+
+	l.Info("hello")
+	if err := l.Sync(); err != nil {
+		t.Fatalf("Sync returned error: %v", err)
+	}
+
+	CloseLogger()
+
+	if _, err := rotator.Write([]byte("after close\n")); err != nil {
+		t.Fatalf("expected lumberjack to reopen the file after Close, got error: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("expected rotated log file to exist: %v", err)
+	}
+	if len(data) == 0 {
+		t.Error("expected log file to contain written data")
+	}
+}